@@ -0,0 +1,69 @@
+package pool
+
+import (
+	"context"
+	"sync"
+)
+
+// Map runs fn over items using at most workers goroutines and returns the
+// results in input order. The first error cancels the remaining work and
+// is returned; once the context is cancelled no further items reach fn.
+// Cancellation of ctx is reported as ctx.Err().
+func Map[T, R any](ctx context.Context, items []T, workers int, fn func(context.Context, T) (R, error)) ([]R, error) {
+	if workers <= 0 || workers > len(items) {
+		workers = len(items)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := make([]R, len(items))
+	idxCh := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idxCh {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				r, err := fn(ctx, items[i])
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				res[i] = r
+			}
+		}()
+	}
+
+feed:
+	for i := range items {
+		select {
+		case idxCh <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(idxCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMap_PreservesOrder(t *testing.T) {
+	items := []int{5, 4, 3, 2, 1, 0}
+
+	got, err := Map(context.Background(), items, len(items), func(_ context.Context, x int) (int, error) {
+		time.Sleep(time.Duration(x) * time.Millisecond)
+		return x * 10, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, x := range items {
+		if got[i] != x*10 {
+			t.Fatalf("result %d: got %d, want %d", i, got[i], x*10)
+		}
+	}
+}
+
+func TestMap_FirstErrorStopsFeeding(t *testing.T) {
+	errBoom := errors.New("boom")
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+
+	var calls atomic.Int64
+	_, err := Map(context.Background(), items, 1, func(_ context.Context, x int) (int, error) {
+		calls.Add(1)
+		if x == 2 {
+			return 0, errBoom
+		}
+		return x, nil
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	if n := calls.Load(); n > 3 {
+		t.Fatalf("expected fn to stop after the failing item, called %d times", n)
+	}
+}
+
+func TestMap_CancelledParent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int64
+	_, err := Map(ctx, []int{1, 2, 3}, 2, func(_ context.Context, x int) (int, error) {
+		calls.Add(1)
+		return x, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("expected fn not to run on a cancelled context, called %d times", n)
+	}
+}
+
+func TestMap_EmptyInput(t *testing.T) {
+	got, err := Map(context.Background(), []int{}, 4, func(_ context.Context, x int) (int, error) {
+		return x, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no results, got %v", got)
+	}
+}
@@ -2,10 +2,19 @@ package models
 
 import (
 	"cmp"
+	"fmt"
 	"slices"
 	"time"
 )
 
+type NormalizeMode int
+
+const (
+	NormalizeWindow NormalizeMode = iota
+	NormalizePerCandle
+	NormalizeNone
+)
+
 type Candle struct {
 	Date  time.Time
 	Open  float64
@@ -14,6 +23,10 @@ type Candle struct {
 	Close float64
 }
 
+func (c Candle) String() string {
+	return fmt.Sprintf("%s O:%g H:%g L:%g C:%g", formatDate(c.Date), c.Open, c.High, c.Low, c.Close)
+}
+
 func (c Candle) Normalize(min, max float64) Candle {
 	rangeVal := max - min
 	if rangeVal == 0 {
@@ -49,3 +62,48 @@ func NormalizeCandles(candles []Candle) []Candle {
 	}
 	return res
 }
+
+// NormalizeCandlesMode normalizes candles according to mode. Unknown modes
+// fall back to NormalizeWindow, the zero value.
+func NormalizeCandlesMode(candles []Candle, mode NormalizeMode) []Candle {
+	if len(candles) == 0 {
+		return nil
+	}
+
+	switch mode {
+	case NormalizePerCandle:
+		res := make([]Candle, 0, len(candles))
+		for i := range candles {
+			res = append(res, candles[i].Normalize(candles[i].Low, candles[i].High))
+		}
+		return res
+	case NormalizeNone:
+		return slices.Clone(candles)
+	default:
+		return NormalizeCandles(candles)
+	}
+}
+
+func (c Candle) Direction() int {
+	return cmp.Compare(c.Close, c.Open)
+}
+
+func SameColors(seed, window []Candle) bool {
+	if len(seed) != len(window) {
+		return false
+	}
+
+	for i := range seed {
+		if seed[i].Direction() != window[i].Direction() {
+			return false
+		}
+	}
+	return true
+}
+
+func formatDate(t time.Time) string {
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format("2006-01-02 15:04:05.999999999")
+}
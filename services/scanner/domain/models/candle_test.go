@@ -0,0 +1,113 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCandle_String_Daily(t *testing.T) {
+	c := Candle{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Open: 100, High: 115, Low: 95, Close: 110}
+
+	want := "2024-01-02 O:100 H:115 L:95 C:110"
+	if got := c.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestCandle_String_Intraday(t *testing.T) {
+	c := Candle{Date: time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC), Open: 1.5, High: 2, Low: 1, Close: 1.75}
+
+	want := "2024-01-02 10:30:00 O:1.5 H:2 L:1 C:1.75"
+	if got := c.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestCandle_String_SubSecond(t *testing.T) {
+	c := Candle{Date: time.Date(2024, 1, 2, 0, 0, 0, 500_000_000, time.UTC)}
+
+	want := "2024-01-02 00:00:00.5 O:0 H:0 L:0 C:0"
+	if got := c.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestChartSegment_String(t *testing.T) {
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	s := ChartSegment{
+		Ticker:  "SBER",
+		From:    from,
+		To:      from.AddDate(0, 0, 3),
+		Candles: make([]Candle, 3),
+	}
+
+	want := "SBER 2024-01-02..2024-01-05 (3 candles)"
+	if got := s.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func colored(dirs ...int) []Candle {
+	res := make([]Candle, 0, len(dirs))
+	for _, d := range dirs {
+		res = append(res, Candle{Open: 10, Close: 10 + float64(d)})
+	}
+	return res
+}
+
+func TestSameColors(t *testing.T) {
+	seed := colored(-1, -1, 1)
+
+	if !SameColors(seed, []Candle{{Open: 50, Close: 40}, {Open: 40, Close: 39.5}, {Open: 39.5, Close: 60}}) {
+		t.Error("expected red-red-green to match regardless of magnitude")
+	}
+	if SameColors(seed, colored(1, -1, 1)) {
+		t.Error("expected green-red-green to be rejected")
+	}
+	if SameColors(seed, colored(-1, 0, 1)) {
+		t.Error("expected a doji to differ from a red candle")
+	}
+	if !SameColors(colored(0, 1), colored(0, 1)) {
+		t.Error("expected doji to match doji")
+	}
+	if SameColors(seed, colored(-1, -1)) {
+		t.Error("expected a length mismatch to be rejected")
+	}
+}
+
+func TestNormalizeCandlesMode_Outlier(t *testing.T) {
+	candles := []Candle{
+		{Open: 10, High: 11, Low: 9.5, Close: 10.5},
+		{Open: 10.5, High: 11.5, Low: 10, Close: 11},
+		{Open: 11, High: 100, Low: 10.5, Close: 12},
+	}
+
+	window := NormalizeCandlesMode(candles, NormalizeWindow)
+	for i := range 2 {
+		if span := window[i].High - window[i].Low; span > 0.05 {
+			t.Errorf("window mode: expected candle %d to be squashed, span %v", i, span)
+		}
+	}
+
+	perCandle := NormalizeCandlesMode(candles, NormalizePerCandle)
+	for i, c := range perCandle {
+		if c.Low != 0 || c.High != 1 {
+			t.Errorf("per-candle mode: candle %d spans [%v,%v], want [0,1]", i, c.Low, c.High)
+		}
+	}
+
+	raw := NormalizeCandlesMode(candles, NormalizeNone)
+	for i := range candles {
+		if raw[i] != candles[i] {
+			t.Errorf("none mode: candle %d changed to %v", i, raw[i])
+		}
+	}
+}
+
+func TestNormalizeCandlesMode_Empty(t *testing.T) {
+	for _, mode := range []NormalizeMode{NormalizeWindow, NormalizePerCandle, NormalizeNone} {
+		if got := NormalizeCandlesMode([]Candle{}, mode); got != nil {
+			t.Errorf("mode %d: expected nil, got %v", mode, got)
+		}
+	}
+}
@@ -1,10 +1,45 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type ChartSegment struct {
 	Ticker  string
 	From    time.Time
 	To      time.Time
 	Candles []Candle
+
+	ScaleFactor float64
+}
+
+func (s ChartSegment) String() string {
+	return fmt.Sprintf("%s %s..%s (%d candles)", s.Ticker, formatDate(s.From), formatDate(s.To), len(s.Candles))
+}
+
+func (s ChartSegment) InPatternReturn() float64 {
+	if len(s.Candles) == 0 || s.Candles[0].Open == 0 {
+		return 0
+	}
+
+	firstOpen := s.Candles[0].Open
+	lastClose := s.Candles[len(s.Candles)-1].Close
+
+	return (lastClose - firstOpen) / firstOpen
+}
+
+func DistinctTickers(segments []ChartSegment) int {
+	seen := make(map[string]struct{}, len(segments))
+	for i := range segments {
+		seen[segments[i].Ticker] = struct{}{}
+	}
+	return len(seen)
+}
+
+func ScaleFactor(windowLen, seedLen int) float64 {
+	if seedLen <= 0 {
+		return 0
+	}
+	return float64(windowLen) / float64(seedLen)
 }
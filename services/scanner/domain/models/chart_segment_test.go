@@ -0,0 +1,47 @@
+package models
+
+import "testing"
+
+func TestScaleFactor(t *testing.T) {
+	if got := ScaleFactor(15, 10); got != 1.5 {
+		t.Errorf("longer window: got %v, want 1.5", got)
+	}
+	if got := ScaleFactor(10, 10); got != 1 {
+		t.Errorf("exact window: got %v, want 1", got)
+	}
+	if got := ScaleFactor(10, 0); got != 0 {
+		t.Errorf("empty seed: got %v, want 0", got)
+	}
+}
+
+func TestChartSegment_InPatternReturn(t *testing.T) {
+	s := ChartSegment{Candles: []Candle{
+		{Open: 100, Close: 104},
+		{Open: 104, Close: 98},
+		{Open: 98, Close: 110},
+	}}
+
+	if got, want := s.InPatternReturn(), (110.0-100.0)/100.0; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestChartSegment_InPatternReturn_Guards(t *testing.T) {
+	if got := (ChartSegment{}).InPatternReturn(); got != 0 {
+		t.Errorf("empty segment: got %v, want 0", got)
+	}
+	if got := (ChartSegment{Candles: []Candle{{Open: 0, Close: 5}}}).InPatternReturn(); got != 0 {
+		t.Errorf("zero open: got %v, want 0", got)
+	}
+}
+
+func TestDistinctTickers(t *testing.T) {
+	segments := []ChartSegment{{Ticker: "SBER"}, {Ticker: "GAZP"}, {Ticker: "SBER"}, {Ticker: "SBER"}}
+
+	if got := DistinctTickers(segments); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+	if got := DistinctTickers(nil); got != 0 {
+		t.Errorf("empty: got %d, want 0", got)
+	}
+}
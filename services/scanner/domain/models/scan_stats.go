@@ -1,7 +1,18 @@
 package models
 
 type ScanStats struct {
-	TotalMatches int
-	PriceChange  float64
-	Probability  float64
+	TotalMatches       int
+	PriceChange        float64
+	Probability        float64
+	UpProbability      float64
+	DownProbability    float64
+	AvgUpReturn        float64
+	AvgDownReturn      float64
+	AvgMFE             float64
+	AvgMAE             float64
+	AvgInPatternReturn float64
+	SharpeRatio        float64
+	DistinctTickers    int
+	NeutralCount       int
+	LowConfidence      bool
 }
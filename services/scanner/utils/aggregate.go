@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+type Period int
+
+const (
+	Weekly Period = iota + 1
+	Monthly
+)
+
+var ErrUnsupportedPeriod = errors.New("unsupported aggregation period")
+
+// Aggregate rolls candles up into weekly (Monday-based) or calendar-month
+// candles. Candles must be ascending by date, otherwise ErrUnorderedCandles
+// is returned; use EnsureAscending for newest-first input. Buckets are
+// computed in each candle's own location. A trailing bucket that is not
+// complete is still returned.
+func Aggregate(candles []models.Candle, period Period) ([]models.Candle, error) {
+	if period != Weekly && period != Monthly {
+		return nil, ErrUnsupportedPeriod
+	}
+	if !slices.IsSortedFunc(candles, compareDates) {
+		return nil, ErrUnorderedCandles
+	}
+	if len(candles) == 0 {
+		return nil, nil
+	}
+
+	res := make([]models.Candle, 0, len(candles))
+
+	var curKey time.Time
+	for i, c := range candles {
+		key := periodStart(c.Date, period)
+
+		if i == 0 || !key.Equal(curKey) {
+			curKey = key
+			res = append(res, c)
+			continue
+		}
+
+		mergeCandle(&res[len(res)-1], c)
+	}
+	return res, nil
+}
+
+// mergeCandle extends dst with the later candle c, keeping dst's date and
+// open.
+func mergeCandle(dst *models.Candle, c models.Candle) {
+	dst.High = max(dst.High, c.High)
+	dst.Low = min(dst.Low, c.Low)
+	dst.Close = c.Close
+}
+
+func periodStart(t time.Time, period Period) time.Time {
+	if period == Monthly {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
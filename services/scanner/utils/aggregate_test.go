@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+func TestAggregate_Weekly_NonUTC(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+
+	var candles []models.Candle
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, msk)
+	for len(candles) < 10 {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			p := float64(100 + len(candles))
+			candles = append(candles, models.Candle{Date: day, Open: p, High: p + 5, Low: p - 5, Close: p + 1})
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	got, err := Aggregate(candles, Weekly)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 weekly candles, got %d", len(got))
+	}
+
+	for w, c := range got {
+		first, last := candles[w*5], candles[w*5+4]
+		want := models.Candle{Date: first.Date, Open: first.Open, High: last.High, Low: first.Low, Close: last.Close}
+		if !c.Date.Equal(want.Date) || c.Open != want.Open || c.High != want.High || c.Low != want.Low || c.Close != want.Close {
+			t.Errorf("week %d: got %v, want %v", w, c, want)
+		}
+	}
+}
+
+func TestAggregate_Monthly_PartialTrailing(t *testing.T) {
+	candles := []models.Candle{
+		{Date: time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC), Open: 1, High: 2, Low: 0.5, Close: 1.5},
+		{Date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Open: 1.5, High: 3, Low: 1, Close: 2},
+		{Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Open: 2, High: 2.5, Low: 1.8, Close: 2.2},
+	}
+
+	got, err := Aggregate(candles, Monthly)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 monthly candles, got %d", len(got))
+	}
+	if got[0].High != 3 || got[0].Low != 0.5 || got[0].Close != 2 {
+		t.Errorf("unexpected January candle: %v", got[0])
+	}
+	if got[1].Close != 2.2 {
+		t.Errorf("unexpected February candle: %v", got[1])
+	}
+}
+
+func TestAggregate_UnsupportedPeriod(t *testing.T) {
+	_, err := Aggregate([]models.Candle{{}}, Period(0))
+	if !errors.Is(err, ErrUnsupportedPeriod) {
+		t.Fatalf("expected ErrUnsupportedPeriod, got %v", err)
+	}
+}
+
+func TestAggregate_Unordered(t *testing.T) {
+	candles := []models.Candle{
+		{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	_, err := Aggregate(candles, Weekly)
+	if !errors.Is(err, ErrUnorderedCandles) {
+		t.Fatalf("expected ErrUnorderedCandles, got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package utils
+
+// LowConfidence reports whether stats built from considered matches fall
+// below minSampleSize and should be flagged rather than trusted.
+func LowConfidence(considered, minSampleSize int) bool {
+	return considered < minSampleSize
+}
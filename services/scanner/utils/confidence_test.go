@@ -0,0 +1,15 @@
+package utils
+
+import "testing"
+
+func TestLowConfidence(t *testing.T) {
+	if !LowConfidence(1, 5) {
+		t.Error("expected a single match to be low confidence")
+	}
+	if LowConfidence(5, 5) {
+		t.Error("expected a sample at the threshold to be confident")
+	}
+	if LowConfidence(10, 5) {
+		t.Error("expected a sample above the threshold to be confident")
+	}
+}
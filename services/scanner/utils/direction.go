@@ -0,0 +1,49 @@
+package utils
+
+// DirectionBreakdown splits per-match forward returns into up (r >= 0) and
+// down (r < 0) moves and returns the share of each along with their mean
+// returns. All values are 0 for empty input.
+func DirectionBreakdown(returns []float64) (upProb, downProb, avgUp, avgDown float64) {
+	if len(returns) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	var upCtr, downCtr int
+	var upSum, downSum float64
+	for _, r := range returns {
+		if r >= 0 {
+			upCtr++
+			upSum += r
+		} else {
+			downCtr++
+			downSum += r
+		}
+	}
+
+	n := float64(len(returns))
+	upProb = float64(upCtr) / n
+	downProb = float64(downCtr) / n
+	if upCtr > 0 {
+		avgUp = upSum / float64(upCtr)
+	}
+	if downCtr > 0 {
+		avgDown = downSum / float64(downCtr)
+	}
+	return upProb, downProb, avgUp, avgDown
+}
+
+// ClassifyReturn returns 1 for a win, -1 for a loss and 0 for a neutral
+// move. With winThreshold > 0, only returns above +winThreshold win, only
+// returns below -winThreshold lose, and everything in between is neutral.
+// With winThreshold <= 0 nothing is neutral: r >= 0 wins, matching
+// DirectionBreakdown.
+func ClassifyReturn(r, winThreshold float64) int {
+	switch {
+	case winThreshold <= 0 && r >= 0, winThreshold > 0 && r > winThreshold:
+		return 1
+	case winThreshold <= 0, r < -winThreshold:
+		return -1
+	default:
+		return 0
+	}
+}
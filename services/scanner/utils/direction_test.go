@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDirectionBreakdown_Mixed(t *testing.T) {
+	returns := []float64{0.01, 0.02, 0.03, 0.04, 0.05, 0.06, -0.01, -0.02, -0.03, -0.06}
+
+	upProb, downProb, avgUp, avgDown := DirectionBreakdown(returns)
+
+	for name, c := range map[string][2]float64{
+		"upProb":   {upProb, 0.6},
+		"downProb": {downProb, 0.4},
+		"avgUp":    {avgUp, 0.035},
+		"avgDown":  {avgDown, -0.03},
+	} {
+		if math.Abs(c[0]-c[1]) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", name, c[0], c[1])
+		}
+	}
+}
+
+func TestDirectionBreakdown_Empty(t *testing.T) {
+	upProb, downProb, avgUp, avgDown := DirectionBreakdown(nil)
+	if upProb != 0 || downProb != 0 || avgUp != 0 || avgDown != 0 {
+		t.Errorf("expected zeros, got %v %v %v %v", upProb, downProb, avgUp, avgDown)
+	}
+}
+
+func TestClassifyReturn(t *testing.T) {
+	cases := []struct {
+		r, threshold float64
+		want         int
+	}{
+		{0.0001, 0.005, 0},
+		{-0.0001, 0.005, 0},
+		{0.01, 0.005, 1},
+		{-0.01, 0.005, -1},
+		{0.0001, 0, 1},
+		{0, 0, 1},
+		{-0.0001, 0, -1},
+	}
+
+	for _, c := range cases {
+		if got := ClassifyReturn(c.r, c.threshold); got != c.want {
+			t.Errorf("ClassifyReturn(%v, %v) = %d, want %d", c.r, c.threshold, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+
+// Excursions walks a forward window and returns the maximum favorable
+// (high-based) and maximum adverse (low-based) moves relative to entry.
+// mfe is never below 0 and mae never above 0.
+func Excursions(entry float64, candles []models.Candle) (mfe, mae float64) {
+	if entry == 0 {
+		return 0, 0
+	}
+
+	for i := range candles {
+		mfe = max(mfe, (candles[i].High-entry)/entry)
+		mae = min(mae, (candles[i].Low-entry)/entry)
+	}
+	return mfe, mae
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"math"
+	"testing"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+func TestExcursions_SpikeThenFlat(t *testing.T) {
+	entry := 100.0
+	window := []models.Candle{
+		{Open: 100, High: 103, Low: 99, Close: 102},
+		{Open: 102, High: 112, Low: 101, Close: 108},
+		{Open: 108, High: 108, Low: 97, Close: 100},
+	}
+	endpoint := (window[len(window)-1].Close - entry) / entry
+
+	mfe, mae := Excursions(entry, window)
+
+	if math.Abs(mfe-0.12) > 1e-9 {
+		t.Errorf("mfe: got %v, want 0.12", mfe)
+	}
+	if mfe <= endpoint {
+		t.Errorf("expected mfe %v to exceed endpoint return %v", mfe, endpoint)
+	}
+	if mae > 0 || math.Abs(mae+0.03) > 1e-9 {
+		t.Errorf("mae: got %v, want -0.03", mae)
+	}
+}
+
+func TestExcursions_ZeroEntry(t *testing.T) {
+	mfe, mae := Excursions(0, []models.Candle{{High: 1, Low: -1}})
+	if mfe != 0 || mae != 0 {
+		t.Errorf("expected zeros, got %v %v", mfe, mae)
+	}
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+const AlertExportVersion = 1
+
+type AlertExport struct {
+	Version int          `json:"version"`
+	Matches []AlertMatch `json:"matches"`
+}
+
+type AlertMatch struct {
+	Ticker string `json:"ticker"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+}
+
+// ExportAlerts renders matched segments as JSON for import into alerting
+// tools such as TradingView. The document has the shape
+//
+//	{
+//	  "version": 1,
+//	  "matches": [
+//	    {"ticker": "SBER", "start": "2024-01-02T00:00:00Z", "end": "2024-01-10T00:00:00Z"}
+//	  ]
+//	}
+//
+// version is AlertExportVersion and changes only on incompatible schema
+// changes. matches holds one entry per segment in input order and is an
+// empty array when there are none. ticker is the matched ticker; start and
+// end are the segment's From and To as RFC 3339 timestamps in UTC.
+func ExportAlerts(segments []models.ChartSegment) ([]byte, error) {
+	export := AlertExport{
+		Version: AlertExportVersion,
+		Matches: make([]AlertMatch, 0, len(segments)),
+	}
+
+	for _, s := range segments {
+		export.Matches = append(export.Matches, AlertMatch{
+			Ticker: s.Ticker,
+			Start:  s.From.UTC().Format(time.RFC3339),
+			End:    s.To.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return json.MarshalIndent(export, "", "  ")
+}
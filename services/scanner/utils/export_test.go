@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+func TestExportAlerts(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	segments := []models.ChartSegment{
+		{
+			Ticker: "SBER",
+			From:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Ticker: "GAZP",
+			From:   time.Date(2024, 2, 1, 10, 0, 0, 0, msk),
+			To:     time.Date(2024, 2, 5, 18, 30, 0, 0, msk),
+		},
+	}
+
+	want := `{
+  "version": 1,
+  "matches": [
+    {
+      "ticker": "SBER",
+      "start": "2024-01-02T00:00:00Z",
+      "end": "2024-01-10T00:00:00Z"
+    },
+    {
+      "ticker": "GAZP",
+      "start": "2024-02-01T07:00:00Z",
+      "end": "2024-02-05T15:30:00Z"
+    }
+  ]
+}`
+
+	got, err := ExportAlerts(segments)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportAlerts_Empty(t *testing.T) {
+	want := `{
+  "version": 1,
+  "matches": []
+}`
+
+	got, err := ExportAlerts(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
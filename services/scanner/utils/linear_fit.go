@@ -0,0 +1,33 @@
+package utils
+
+// LinearFitR2 returns the coefficient of determination of a least-squares
+// line fitted to prices against their index, clamped to [0,1]. Values are
+// centered on their means first, so the result does not depend on the
+// price level. A flat series yields 0.
+func LinearFitR2(prices []float64) float64 {
+	n := float64(len(prices))
+	if n < 2 {
+		return 0
+	}
+
+	var sumY float64
+	for _, y := range prices {
+		sumY += y
+	}
+	meanX := (n - 1) / 2
+	meanY := sumY / n
+
+	var covXY, varX, varY float64
+	for i, y := range prices {
+		dx := float64(i) - meanX
+		dy := y - meanY
+		covXY += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+
+	return min(max(covXY*covXY/(varX*varY), 0), 1)
+}
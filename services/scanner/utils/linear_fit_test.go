@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearFitR2_CleanTrend(t *testing.T) {
+	for _, base := range []float64{100, 1e7} {
+		prices := make([]float64, 50)
+		for i := range prices {
+			prices[i] = base + float64(i)*0.5
+		}
+
+		if r2 := LinearFitR2(prices); math.Abs(r2-1) > 1e-9 {
+			t.Errorf("base %g: expected R² ≈ 1, got %v", base, r2)
+		}
+	}
+}
+
+func TestLinearFitR2_Noise(t *testing.T) {
+	noise := make([]float64, 200)
+	for i := range noise {
+		noise[i] = 0.01 * math.Sin(float64(i)*2.3) * math.Cos(float64(i)*7.1)
+	}
+
+	var first float64
+	for i, base := range []float64{100, 1e6, 1e7} {
+		prices := make([]float64, len(noise))
+		for j, v := range noise {
+			prices[j] = base + v
+		}
+
+		r2 := LinearFitR2(prices)
+		if r2 < 0 || r2 > 0.05 {
+			t.Errorf("base %g: expected R² ≈ 0, got %v", base, r2)
+		}
+		if i == 0 {
+			first = r2
+		} else if math.Abs(r2-first) > 1e-6 {
+			t.Errorf("base %g: R² %v differs from base 100 value %v", base, r2, first)
+		}
+	}
+}
+
+func TestLinearFitR2_Degenerate(t *testing.T) {
+	if r2 := LinearFitR2([]float64{5}); r2 != 0 {
+		t.Errorf("single value: expected 0, got %v", r2)
+	}
+	if r2 := LinearFitR2([]float64{3, 3, 3}); r2 != 0 {
+		t.Errorf("flat series: expected 0, got %v", r2)
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+var ErrInvalidMove = errors.New("move must be finite and greater than -1")
+
+// CandlesFromMoves builds a seed series from relative price moves, e.g.
+// 0.05 for +5%. Each move becomes one candle opening at the previous close,
+// starting from a price of 1 at from and spaced by step. Moves of -1 or
+// below, NaN and infinities would corrupt the seed and return
+// ErrInvalidMove.
+func CandlesFromMoves(moves []float64, from time.Time, step time.Duration) ([]models.Candle, error) {
+	if len(moves) == 0 {
+		return nil, nil
+	}
+
+	for _, m := range moves {
+		if !(m > -1) || math.IsInf(m, 0) {
+			return nil, ErrInvalidMove
+		}
+	}
+
+	res := make([]models.Candle, 0, len(moves))
+	price := 1.0
+	for i, m := range moves {
+		next := price * (1 + m)
+		res = append(res, models.Candle{
+			Date:  from.Add(time.Duration(i) * step),
+			Open:  price,
+			High:  max(price, next),
+			Low:   min(price, next),
+			Close: next,
+		})
+		price = next
+	}
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCandlesFromMoves(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := CandlesFromMoves([]float64{0.05, -0.03}, from, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 candles, got %d", len(got))
+	}
+	if math.Abs(got[0].Close-1.05) > 1e-9 || math.Abs(got[1].Close-1.05*0.97) > 1e-9 {
+		t.Errorf("unexpected closes: %v, %v", got[0].Close, got[1].Close)
+	}
+	if got[1].Open != got[0].Close || !got[1].Date.Equal(from.Add(24*time.Hour)) {
+		t.Errorf("second candle not chained to first: %v", got[1])
+	}
+}
+
+func TestCandlesFromMoves_RejectsInvalidMoves(t *testing.T) {
+	for _, m := range []float64{-1, -1.5, math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := CandlesFromMoves([]float64{0.1, m}, time.Time{}, time.Hour); !errors.Is(err, ErrInvalidMove) {
+			t.Errorf("move %v: expected ErrInvalidMove, got %v", m, err)
+		}
+	}
+}
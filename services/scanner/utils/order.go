@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+var ErrUnorderedCandles = errors.New("candles are neither ascending nor descending by date")
+
+// EnsureAscending returns a copy of candles ordered by ascending Date.
+// Descending input is reversed; input in any other order is rejected with
+// ErrUnorderedCandles rather than reordered.
+func EnsureAscending(candles []models.Candle) ([]models.Candle, error) {
+	res := slices.Clone(candles)
+
+	if slices.IsSortedFunc(res, compareDates) {
+		return res, nil
+	}
+
+	if slices.IsSortedFunc(res, func(a, b models.Candle) int { return compareDates(b, a) }) {
+		slices.Reverse(res)
+		return res, nil
+	}
+
+	return nil, ErrUnorderedCandles
+}
+
+func compareDates(a, b models.Candle) int {
+	return a.Date.Compare(b.Date)
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+func candlesOnDays(days ...int) []models.Candle {
+	res := make([]models.Candle, 0, len(days))
+	for _, d := range days {
+		res = append(res, models.Candle{Date: time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC), Close: float64(d)})
+	}
+	return res
+}
+
+func TestEnsureAscending_ReturnsCopy(t *testing.T) {
+	for name, in := range map[string][]models.Candle{
+		"ascending":  candlesOnDays(1, 2, 3),
+		"descending": candlesOnDays(3, 2, 1),
+	} {
+		got, err := EnsureAscending(in)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for i, c := range got {
+			if c.Close != float64(i+1) {
+				t.Fatalf("%s: position %d has day %v", name, i, c.Close)
+			}
+		}
+
+		got[0].Close = -1
+		for _, c := range in {
+			if c.Close == -1 {
+				t.Fatalf("%s: result aliases the input slice", name)
+			}
+		}
+	}
+}
+
+func TestEnsureAscending_Unordered(t *testing.T) {
+	_, err := EnsureAscending(candlesOnDays(2, 1, 3))
+	if !errors.Is(err, ErrUnorderedCandles) {
+		t.Fatalf("expected ErrUnorderedCandles, got %v", err)
+	}
+}
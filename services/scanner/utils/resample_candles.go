@@ -0,0 +1,27 @@
+package utils
+
+import "github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+
+// ResampleCandles maps candles onto targetLen buckets for display. Each
+// bucket takes the first open and date, the last close, the max high and
+// the min low of the candles it covers. When targetLen exceeds the input
+// length, candles are repeated.
+func ResampleCandles(candles []models.Candle, targetLen int) []models.Candle {
+	n := len(candles)
+	if n == 0 || targetLen <= 0 {
+		return nil
+	}
+
+	res := make([]models.Candle, 0, targetLen)
+	for i := 0; i < targetLen; i++ {
+		start := i * n / targetLen
+		end := max((i+1)*n/targetLen, start+1)
+
+		c := candles[start]
+		for _, next := range candles[start+1 : end] {
+			mergeCandle(&c, next)
+		}
+		res = append(res, c)
+	}
+	return res
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m1keee3/FinanceAnalyst/services/scanner/domain/models"
+)
+
+func sampleCandles() []models.Candle {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []models.Candle{
+		{Date: day, Open: 10, High: 12, Low: 9, Close: 11},
+		{Date: day.AddDate(0, 0, 1), Open: 11, High: 15, Low: 10, Close: 14},
+		{Date: day.AddDate(0, 0, 2), Open: 14, High: 14, Low: 7, Close: 8},
+		{Date: day.AddDate(0, 0, 3), Open: 8, High: 9, Low: 6, Close: 9},
+	}
+}
+
+func TestResampleCandles_Downsample(t *testing.T) {
+	candles := sampleCandles()
+
+	got := ResampleCandles(candles, 2)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 candles, got %d", len(got))
+	}
+
+	want := []models.Candle{
+		{Date: candles[0].Date, Open: 10, High: 15, Low: 9, Close: 14},
+		{Date: candles[2].Date, Open: 14, High: 14, Low: 6, Close: 9},
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("bucket %d: got %v, want %v", i, got[i], want[i])
+		}
+		if got[i].High < max(got[i].Open, got[i].Close) || got[i].Low > min(got[i].Open, got[i].Close) {
+			t.Errorf("bucket %d violates OHLC invariants: %v", i, got[i])
+		}
+	}
+}
+
+func TestResampleCandles_Upsample(t *testing.T) {
+	candles := sampleCandles()[:2]
+
+	got := ResampleCandles(candles, 4)
+	if len(got) != 4 {
+		t.Fatalf("expected 4 candles, got %d", len(got))
+	}
+
+	for i, src := range []int{0, 0, 1, 1} {
+		if got[i] != candles[src] {
+			t.Errorf("position %d: got %v, want repeated %v", i, got[i], candles[src])
+		}
+	}
+}
+
+func TestResampleCandles_NonPositiveTarget(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if got := ResampleCandles(sampleCandles(), n); got != nil {
+			t.Errorf("targetLen %d: expected nil, got %v", n, got)
+		}
+	}
+}
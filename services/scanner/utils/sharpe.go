@@ -0,0 +1,30 @@
+package utils
+
+import "math"
+
+// SharpeRatio returns the mean of returns divided by their sample standard
+// deviation. It returns 0 when there are fewer than two returns or no
+// variance.
+func SharpeRatio(returns []float64) float64 {
+	n := float64(len(returns))
+	if n < 2 {
+		return 0
+	}
+
+	var sum float64
+	for _, r := range returns {
+		sum += r
+	}
+	mean := sum / n
+
+	var sq float64
+	for _, r := range returns {
+		sq += (r - mean) * (r - mean)
+	}
+	stdDev := math.Sqrt(sq / (n - 1))
+	if stdDev == 0 {
+		return 0
+	}
+
+	return mean / stdDev
+}
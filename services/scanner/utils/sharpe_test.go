@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSharpeRatio_KnownReturns(t *testing.T) {
+	// mean 0.02, sample stddev sqrt(0.0002/3)
+	returns := []float64{0.01, 0.03, 0.02, 0.02}
+	want := 0.02 / math.Sqrt(0.0002/3)
+
+	if got := SharpeRatio(returns); math.Abs(got-want) > 1e-9 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSharpeRatio_ZeroVariance(t *testing.T) {
+	if got := SharpeRatio([]float64{0.25, 0.25, 0.25}); got != 0 {
+		t.Errorf("expected 0, got %v", got)
+	}
+}
+
+func TestSharpeRatio_TooFewReturns(t *testing.T) {
+	for _, returns := range [][]float64{nil, {0.05}} {
+		if got := SharpeRatio(returns); got != 0 {
+			t.Errorf("%v: expected 0, got %v", returns, got)
+		}
+	}
+}